@@ -1,60 +1,101 @@
 package main
 
 import (
+	"context"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+
 	"url-shortener/internal/config"
+	"url-shortener/internal/http-server/handlers/redirect"
+	del "url-shortener/internal/http-server/handlers/url/delete"
+	"url-shortener/internal/http-server/handlers/url/list"
+	"url-shortener/internal/http-server/handlers/url/save"
+	mwLogger "url-shortener/internal/http-server/middleware/logger"
+	"url-shortener/internal/lib/logger"
 	"url-shortener/internal/lib/logger/sl"
-	"url-shortener/internal/storage/sqlite"
-)
+	"url-shortener/internal/storage"
+	_ "url-shortener/internal/storage/postgres"
+	_ "url-shortener/internal/storage/sqlite"
 
-const (
-	envLocal = "local"
-	envDev   = "dev"
-	envProd  = "prod"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 )
 
 func main() {
 	cfg := config.MustLoad()
 
-	log := setupLogger(cfg.Env)
+	log := logger.Setup(cfg)
 
 	log.Info("starting url-shortener", slog.String("env", cfg.Env))
 	log.Debug("debug messages are enabled")
 
-	storage, err := sqlite.NewStorage(cfg.StoragePath)
+	store, err := storage.New(cfg)
 	if err != nil {
 		log.Error("failed to create storage", sl.Error(err))
 		os.Exit(1)
 	}
 
-	id, err := storage.SaveURL("https://google.com", "google")
-	if err != nil {
-		log.Error("failed to save url", sl.Error(err))
+	lister, ok := store.(storage.URLLister)
+	if !ok {
+		log.Error("storage driver does not support listing urls", slog.String("type", cfg.Storage.Type))
 		os.Exit(1)
 	}
 
-	log.Info("url saved", slog.Int64("id", id))
+	router := chi.NewRouter()
 
-	id, err = storage.SaveURL("https://google.com", "google")
-	if err != nil {
-		log.Error("failed to save url", sl.Error(err))
-		os.Exit(1)
+	router.Use(middleware.RequestID)
+	router.Use(mwLogger.New(log))
+	router.Use(middleware.Recoverer)
+	router.Use(middleware.Timeout(cfg.Timeout))
+	router.Use(middleware.URLFormat)
+
+	router.Post("/url", save.New(log, store, cfg.Alias))
+	router.Get("/{alias}", redirect.New(log, store))
+
+	router.Group(func(r chi.Router) {
+		r.Use(middleware.BasicAuth("url-shortener", map[string]string{cfg.HTTPServer.User: cfg.HTTPServer.Password}))
+
+		r.Delete("/url/{alias}", del.New(log, store))
+		r.Get("/urls", list.New(log, lister))
+	})
+
+	log.Info("starting server", slog.String("address", cfg.Address))
+
+	srv := &http.Server{
+		Addr:         cfg.Address,
+		Handler:      router,
+		ReadTimeout:  cfg.Timeout,
+		WriteTimeout: cfg.Timeout,
+		IdleTimeout:  cfg.IdleTimeout,
 	}
 
-	_ = storage
-}
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("failed to start server", sl.Error(err))
+			os.Exit(1)
+		}
+	}()
+
+	log.Info("server started")
+
+	<-done
+
+	log.Info("stopping server")
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Error("failed to stop server", sl.Error(err))
 
-func setupLogger(env string) *slog.Logger {
-	var log *slog.Logger
-	switch env {
-	case envLocal:
-		log = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
-	case envDev:
-		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
-	case envProd:
-		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		return
 	}
 
-	return log
+	log.Info("server stopped")
 }
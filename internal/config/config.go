@@ -0,0 +1,162 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+const (
+	StorageTypeSQLite   = "sqlite"
+	StorageTypePostgres = "postgres"
+)
+
+const (
+	LogFormatText   = "text"
+	LogFormatJSON   = "json"
+	LogFormatPretty = "pretty"
+
+	LogOutputStdout = "stdout"
+	LogOutputFile   = "file"
+	LogOutputSyslog = "syslog"
+)
+
+type Config struct {
+	Env        string  `yaml:"env" env:"URLSHORTENER_ENV" env-default:"local"`
+	Storage    Storage `yaml:"storage"`
+	Logger     Logger  `yaml:"logger"`
+	Alias      Alias   `yaml:"alias"`
+	HTTPServer `yaml:"http_server"`
+}
+
+// Alias controls how the save handler generates an alias when the client
+// doesn't supply one: MinLength is the starting length, bumped by one on
+// each storage.ErrURLExists collision up to MaxLength.
+type Alias struct {
+	MinLength int    `yaml:"min_length" env:"URLSHORTENER_ALIAS_MIN_LENGTH" env-default:"6"`
+	MaxLength int    `yaml:"max_length" env:"URLSHORTENER_ALIAS_MAX_LENGTH" env-default:"12"`
+	Alphabet  string `yaml:"alphabet" env:"URLSHORTENER_ALIAS_ALPHABET"`
+}
+
+type Logger struct {
+	Level   string         `yaml:"level" env:"URLSHORTENER_LOGGER_LEVEL" env-default:"info"`
+	Format  string         `yaml:"format" env:"URLSHORTENER_LOGGER_FORMAT" env-default:"text"`
+	Outputs []LoggerOutput `yaml:"outputs"`
+}
+
+type LoggerOutput struct {
+	Type string           `yaml:"type"`
+	File LoggerFileOutput `yaml:"file"`
+}
+
+type LoggerFileOutput struct {
+	Path       string `yaml:"path"`
+	MaxSize    int    `yaml:"max_size"`
+	MaxBackups int    `yaml:"max_backups"`
+	MaxAge     int    `yaml:"max_age"`
+	Compress   bool   `yaml:"compress"`
+}
+
+type Storage struct {
+	Type     string          `yaml:"type" env:"URLSHORTENER_STORAGE_TYPE" env-default:"sqlite"`
+	SQLite   SQLiteStorage   `yaml:"sqlite"`
+	Postgres PostgresStorage `yaml:"postgres"`
+}
+
+type SQLiteStorage struct {
+	FilePath string `yaml:"file_path" env:"URLSHORTENER_STORAGE_PATH"`
+}
+
+type PostgresStorage struct {
+	Host     string `yaml:"host" env:"URLSHORTENER_STORAGE_HOST"`
+	Port     string `yaml:"port" env:"URLSHORTENER_STORAGE_PORT" env-default:"5432"`
+	User     string `yaml:"user" env:"URLSHORTENER_STORAGE_USER"`
+	Password string `yaml:"password" env:"URLSHORTENER_STORAGE_PASSWORD"`
+	DB       string `yaml:"db" env:"URLSHORTENER_STORAGE_DB"`
+	SSLMode  string `yaml:"ssl_mode" env:"URLSHORTENER_STORAGE_SSL_MODE" env-default:"disable"`
+}
+
+type HTTPServer struct {
+	Address     string        `yaml:"address" env:"URLSHORTENER_HTTP_SERVER_ADDRESS" env-default:"localhost:8082"`
+	Timeout     time.Duration `yaml:"timeout" env:"URLSHORTENER_HTTP_SERVER_TIMEOUT" env-default:"4s"`
+	IdleTimeout time.Duration `yaml:"idle_timeout" env:"URLSHORTENER_HTTP_SERVER_IDLE_TIMEOUT" env-default:"60s"`
+	User        string        `yaml:"user" env:"URLSHORTENER_HTTP_SERVER_USER"`
+	Password    string        `yaml:"password" env:"URLSHORTENER_HTTP_SERVER_PASSWORD"`
+}
+
+// MustLoad loads the config from the file at CONFIG_PATH, if set, and then
+// applies URLSHORTENER_-prefixed environment variable overrides on top.
+// CONFIG_PATH is optional: when it is unset, the config is built from
+// environment variables and field defaults alone. It panics on any error,
+// since a broken config means the service cannot run at all.
+func MustLoad() *Config {
+	var cfg Config
+
+	if configPath := os.Getenv("CONFIG_PATH"); configPath != "" {
+		if _, err := os.Stat(configPath); os.IsNotExist(err) {
+			panic("config file does not exist: " + configPath)
+		}
+
+		if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
+			panic("failed to read config: " + err.Error())
+		}
+	} else if err := cleanenv.ReadEnv(&cfg); err != nil {
+		panic("failed to read config: " + err.Error())
+	}
+
+	if err := cfg.Validate(); err != nil {
+		panic("invalid config: " + err.Error())
+	}
+
+	return &cfg
+}
+
+// Validate checks the fields that cleanenv's tags cannot express on their
+// own, such as the storage type being one of the drivers we ship.
+func (c *Config) Validate() error {
+	switch c.Storage.Type {
+	case StorageTypeSQLite:
+		if c.Storage.SQLite.FilePath == "" {
+			return fmt.Errorf("storage.sqlite.file_path is required")
+		}
+	case StorageTypePostgres:
+		if c.Storage.Postgres.Host == "" {
+			return fmt.Errorf("storage.postgres.host is required")
+		}
+	default:
+		return fmt.Errorf("unknown storage type %q", c.Storage.Type)
+	}
+
+	if c.HTTPServer.Address == "" {
+		return fmt.Errorf("http_server.address is required")
+	}
+	if c.HTTPServer.User == "" || c.HTTPServer.Password == "" {
+		return fmt.Errorf("http_server.user and http_server.password are required to protect the admin endpoints")
+	}
+
+	switch c.Logger.Format {
+	case "", LogFormatText, LogFormatJSON, LogFormatPretty:
+	default:
+		return fmt.Errorf("unknown logger format %q", c.Logger.Format)
+	}
+
+	for _, output := range c.Logger.Outputs {
+		switch output.Type {
+		case LogOutputStdout, LogOutputSyslog:
+		case LogOutputFile:
+			if output.File.Path == "" {
+				return fmt.Errorf("logger.outputs: file output requires a path")
+			}
+		default:
+			return fmt.Errorf("unknown logger output type %q", output.Type)
+		}
+	}
+
+	if c.Alias.MinLength > c.Alias.MaxLength {
+		return fmt.Errorf("alias.min_length must not be greater than alias.max_length")
+	}
+
+	return nil
+}
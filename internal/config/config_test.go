@@ -0,0 +1,154 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfigYAML = `
+env: "local"
+storage:
+  type: "sqlite"
+  sqlite:
+    file_path: "./storage/storage.db"
+http_server:
+  address: "localhost:8082"
+  user: "admin"
+  password: "admin"
+`
+
+func writeTestConfig(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+
+	return path
+}
+
+func TestMustLoad_FileOnly(t *testing.T) {
+	path := writeTestConfig(t, testConfigYAML)
+	t.Setenv("CONFIG_PATH", path)
+
+	cfg := MustLoad()
+
+	if cfg.Env != "local" {
+		t.Errorf("Env = %q, want %q", cfg.Env, "local")
+	}
+	if cfg.Storage.SQLite.FilePath != "./storage/storage.db" {
+		t.Errorf("Storage.SQLite.FilePath = %q, want %q", cfg.Storage.SQLite.FilePath, "./storage/storage.db")
+	}
+	if cfg.HTTPServer.Address != "localhost:8082" {
+		t.Errorf("HTTPServer.Address = %q, want %q", cfg.HTTPServer.Address, "localhost:8082")
+	}
+}
+
+func TestMustLoad_EnvOverridesFile(t *testing.T) {
+	path := writeTestConfig(t, testConfigYAML)
+	t.Setenv("CONFIG_PATH", path)
+	t.Setenv("URLSHORTENER_ENV", "prod")
+	t.Setenv("URLSHORTENER_HTTP_SERVER_ADDRESS", "0.0.0.0:9090")
+
+	cfg := MustLoad()
+
+	if cfg.Env != "prod" {
+		t.Errorf("Env = %q, want %q (env override)", cfg.Env, "prod")
+	}
+	if cfg.HTTPServer.Address != "0.0.0.0:9090" {
+		t.Errorf("HTTPServer.Address = %q, want %q (env override)", cfg.HTTPServer.Address, "0.0.0.0:9090")
+	}
+	// Values not overridden by env must still come from the file.
+	if cfg.Storage.SQLite.FilePath != "./storage/storage.db" {
+		t.Errorf("Storage.SQLite.FilePath = %q, want %q", cfg.Storage.SQLite.FilePath, "./storage/storage.db")
+	}
+}
+
+func TestMustLoad_EnvOnly_NoConfigPath(t *testing.T) {
+	t.Setenv("URLSHORTENER_STORAGE_TYPE", "sqlite")
+	t.Setenv("URLSHORTENER_STORAGE_PATH", "./storage/env-only.db")
+	t.Setenv("URLSHORTENER_HTTP_SERVER_ADDRESS", "localhost:8082")
+	t.Setenv("URLSHORTENER_HTTP_SERVER_USER", "admin")
+	t.Setenv("URLSHORTENER_HTTP_SERVER_PASSWORD", "admin")
+
+	cfg := MustLoad()
+
+	if cfg.Storage.SQLite.FilePath != "./storage/env-only.db" {
+		t.Errorf("Storage.SQLite.FilePath = %q, want %q", cfg.Storage.SQLite.FilePath, "./storage/env-only.db")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			name: "valid sqlite config",
+			cfg: Config{
+				Storage:    Storage{Type: StorageTypeSQLite, SQLite: SQLiteStorage{FilePath: "./storage.db"}},
+				HTTPServer: HTTPServer{Address: "localhost:8082", User: "admin", Password: "admin"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid postgres config",
+			cfg: Config{
+				Storage:    Storage{Type: StorageTypePostgres, Postgres: PostgresStorage{Host: "localhost"}},
+				HTTPServer: HTTPServer{Address: "localhost:8082", User: "admin", Password: "admin"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing sqlite file path",
+			cfg: Config{
+				Storage:    Storage{Type: StorageTypeSQLite},
+				HTTPServer: HTTPServer{Address: "localhost:8082"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing postgres host",
+			cfg: Config{
+				Storage:    Storage{Type: StorageTypePostgres},
+				HTTPServer: HTTPServer{Address: "localhost:8082"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown storage type",
+			cfg: Config{
+				Storage:    Storage{Type: "mongo"},
+				HTTPServer: HTTPServer{Address: "localhost:8082"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing http address",
+			cfg: Config{
+				Storage: Storage{Type: StorageTypeSQLite, SQLite: SQLiteStorage{FilePath: "./storage.db"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing admin credentials",
+			cfg: Config{
+				Storage:    Storage{Type: StorageTypeSQLite, SQLite: SQLiteStorage{FilePath: "./storage.db"}},
+				HTTPServer: HTTPServer{Address: "localhost:8082"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
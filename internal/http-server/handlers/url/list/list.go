@@ -0,0 +1,89 @@
+package list
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	resp "url-shortener/internal/lib/api/response"
+	"url-shortener/internal/lib/logger/sl"
+	"url-shortener/internal/storage"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 100
+)
+
+// URLLister is the subset of storage.URLLister the list handler depends
+// on, kept narrow so the handler can be tested without a real database.
+type URLLister interface {
+	ListURLs(ctx context.Context, limit, offset int) ([]storage.URLRecord, error)
+}
+
+type Response struct {
+	resp.Response
+	URLs       []storage.URLRecord `json:"urls"`
+	NextOffset int                 `json:"next_offset,omitempty"`
+}
+
+func New(log *slog.Logger, urlLister URLLister) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.url.list.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		limit := parseQueryInt(r, "limit", defaultLimit)
+		if limit <= 0 || limit > maxLimit {
+			limit = defaultLimit
+		}
+
+		offset := parseQueryInt(r, "offset", 0)
+		if offset < 0 {
+			offset = 0
+		}
+
+		urls, err := urlLister.ListURLs(r.Context(), limit, offset)
+		if err != nil {
+			log.Error("failed to list urls", sl.Error(err))
+
+			render.JSON(w, r, resp.Error("internal error"))
+
+			return
+		}
+
+		log.Info("listed urls", slog.Int("count", len(urls)), slog.Int("offset", offset))
+
+		response := Response{
+			Response: resp.OK(),
+			URLs:     urls,
+		}
+
+		if len(urls) == limit {
+			response.NextOffset = offset + len(urls)
+		}
+
+		render.JSON(w, r, response)
+	}
+}
+
+func parseQueryInt(r *http.Request, key string, def int) int {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return def
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
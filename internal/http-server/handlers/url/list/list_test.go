@@ -0,0 +1,148 @@
+package list_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"url-shortener/internal/http-server/handlers/url/list"
+	"url-shortener/internal/storage"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	testUser     = "admin"
+	testPassword = "secret"
+	testRealm    = "url-shortener"
+)
+
+type mockLister struct {
+	records []storage.URLRecord
+}
+
+func (m *mockLister) ListURLs(_ context.Context, limit, offset int) ([]storage.URLRecord, error) {
+	if offset >= len(m.records) {
+		return []storage.URLRecord{}, nil
+	}
+
+	end := offset + limit
+	if end > len(m.records) {
+		end = len(m.records)
+	}
+
+	return m.records[offset:end], nil
+}
+
+func newTestServer(t *testing.T, lister list.URLLister) *httptest.Server {
+	t.Helper()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	router := chi.NewRouter()
+	router.Group(func(r chi.Router) {
+		r.Use(middleware.BasicAuth(testRealm, map[string]string{testUser: testPassword}))
+		r.Get("/urls", list.New(log, lister))
+	})
+
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestList_RequiresAuth(t *testing.T) {
+	srv := newTestServer(t, &mockLister{})
+
+	resp, err := http.Get(srv.URL + "/urls")
+	if err != nil {
+		t.Fatalf("GET /urls: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	wantChallenge := fmt.Sprintf(`Basic realm="%s"`, testRealm)
+	if got := resp.Header.Get("WWW-Authenticate"); got != wantChallenge {
+		t.Errorf("WWW-Authenticate = %q, want %q", got, wantChallenge)
+	}
+}
+
+func TestList_RejectsWrongCredentials(t *testing.T) {
+	srv := newTestServer(t, &mockLister{})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/urls", nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.SetBasicAuth(testUser, "wrong-password")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /urls: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestList_Pagination(t *testing.T) {
+	records := []storage.URLRecord{
+		{ID: 1, Alias: "a1", URL: "https://example.com/1"},
+		{ID: 2, Alias: "a2", URL: "https://example.com/2"},
+		{ID: 3, Alias: "a3", URL: "https://example.com/3"},
+	}
+
+	srv := newTestServer(t, &mockLister{records: records})
+
+	fetchPage := func(limit, offset int) list.Response {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/urls?limit=%d&offset=%d", srv.URL, limit, offset), nil)
+		if err != nil {
+			t.Fatalf("build request: %v", err)
+		}
+		req.SetBasicAuth(testUser, testPassword)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET /urls: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+
+		var out list.Response
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+
+		return out
+	}
+
+	first := fetchPage(2, 0)
+	if len(first.URLs) != 2 || first.NextOffset != 2 {
+		t.Fatalf("first page = %+v, want 2 urls and next_offset 2", first)
+	}
+
+	second := fetchPage(2, first.NextOffset)
+	if len(second.URLs) != 1 || second.NextOffset != 0 {
+		t.Fatalf("second page = %+v, want 1 url and no next_offset", second)
+	}
+
+	if second.URLs[0].Alias != records[2].Alias {
+		t.Errorf("second page alias = %q, want %q", second.URLs[0].Alias, records[2].Alias)
+	}
+}
@@ -0,0 +1,143 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"url-shortener/internal/config"
+	resp "url-shortener/internal/lib/api/response"
+	"url-shortener/internal/lib/logger/sl"
+	"url-shortener/internal/lib/random"
+	"url-shortener/internal/storage"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type Request struct {
+	URL   string `json:"url" validate:"required,url"`
+	Alias string `json:"alias,omitempty"`
+}
+
+type Response struct {
+	resp.Response
+	Alias string `json:"alias,omitempty"`
+}
+
+// URLSaver is the subset of storage.Storage the save handler depends on,
+// kept narrow so the handler can be tested without a real database.
+type URLSaver interface {
+	SaveURL(urlToSave string, alias string) (int64, error)
+}
+
+func New(log *slog.Logger, urlSaver URLSaver, aliasCfg config.Alias) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.url.save.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", sl.Error(err))
+
+			render.JSON(w, r, resp.Error("failed to decode request"))
+
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request", req))
+
+		if err := validator.New().Struct(req); err != nil {
+			var validateErr validator.ValidationErrors
+			if errors.As(err, &validateErr) {
+				log.Error("invalid request", sl.Error(err))
+
+				render.JSON(w, r, resp.ValidationError(validateErr))
+
+				return
+			}
+
+			log.Error("failed to validate request", sl.Error(err))
+
+			render.JSON(w, r, resp.Error("failed to validate request"))
+
+			return
+		}
+
+		alias := req.Alias
+
+		var (
+			id  int64
+			err error
+		)
+
+		if alias != "" {
+			id, err = urlSaver.SaveURL(req.URL, alias)
+		} else {
+			alias, id, err = saveWithGeneratedAlias(urlSaver, req.URL, aliasCfg)
+		}
+
+		if errors.Is(err, storage.ErrURLExists) {
+			log.Info("url already exists", slog.String("url", req.URL))
+
+			render.JSON(w, r, resp.Error("url already exists"))
+
+			return
+		}
+		if err != nil {
+			log.Error("failed to add url", sl.Error(err))
+
+			render.JSON(w, r, resp.Error("failed to add url"))
+
+			return
+		}
+
+		log.Info("url added", slog.Int64("id", id))
+
+		render.JSON(w, r, Response{
+			Response: resp.OK(),
+			Alias:    alias,
+		})
+	}
+}
+
+// saveWithGeneratedAlias generates an alias and saves urlToSave under it,
+// retrying with one character more each time it collides with an existing
+// alias, up to aliasCfg.MaxLength.
+func saveWithGeneratedAlias(urlSaver URLSaver, urlToSave string, aliasCfg config.Alias) (string, int64, error) {
+	length := aliasCfg.MinLength
+	if length <= 0 {
+		length = 6
+	}
+
+	maxLength := aliasCfg.MaxLength
+	if maxLength < length {
+		maxLength = length
+	}
+
+	for l := length; l <= maxLength; l++ {
+		alias, err := random.New(l, aliasCfg.Alphabet)
+		if err != nil {
+			return "", 0, err
+		}
+
+		id, err := urlSaver.SaveURL(urlToSave, alias)
+		if errors.Is(err, storage.ErrURLExists) {
+			continue
+		}
+		if err != nil {
+			return "", 0, err
+		}
+
+		return alias, id, nil
+	}
+
+	return "", 0, fmt.Errorf("failed to generate a unique alias up to length %d", maxLength)
+}
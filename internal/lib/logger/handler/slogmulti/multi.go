@@ -0,0 +1,64 @@
+// Package slogmulti fans a single slog.Record out to several child
+// handlers, the way samber/slog-multi's Fanout handler does, so a logger
+// can write to stdout, a rotating file, and syslog at once.
+package slogmulti
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+type fanoutHandler struct {
+	handlers []slog.Handler
+}
+
+// Fanout combines handlers into a single handler that duplicates every
+// Record to each of them, merging whatever errors they return.
+func Fanout(handlers ...slog.Handler) slog.Handler {
+	return &fanoutHandler{handlers: handlers}
+}
+
+func (h *fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h.handlers {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *fanoutHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+
+	for _, handler := range h.handlers {
+		if !handler.Enabled(ctx, record.Level) {
+			continue
+		}
+
+		if err := handler.Handle(ctx, record.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]slog.Handler, len(h.handlers))
+	for i, handler := range h.handlers {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+
+	return Fanout(handlers...)
+}
+
+func (h *fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make([]slog.Handler, len(h.handlers))
+	for i, handler := range h.handlers {
+		handlers[i] = handler.WithGroup(name)
+	}
+
+	return Fanout(handlers...)
+}
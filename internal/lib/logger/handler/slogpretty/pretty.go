@@ -0,0 +1,104 @@
+// Package slogpretty provides a slog.Handler that renders records for a
+// human watching a terminal: colorized level and message, and an
+// indented, pretty-printed JSON block for the record's attrs.
+package slogpretty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/fatih/color"
+)
+
+type PrettyHandlerOptions struct {
+	SlogOpts *slog.HandlerOptions
+}
+
+type PrettyHandler struct {
+	slog.Handler
+	attrs []slog.Attr
+	out   io.Writer
+	mu    *sync.Mutex
+}
+
+func (o PrettyHandlerOptions) NewPrettyHandler(out io.Writer) *PrettyHandler {
+	return &PrettyHandler{
+		Handler: slog.NewJSONHandler(out, o.SlogOpts),
+		out:     out,
+		mu:      &sync.Mutex{},
+	}
+}
+
+func (h *PrettyHandler) Handle(_ context.Context, r slog.Record) error {
+	level := r.Level.String() + ":"
+
+	switch r.Level {
+	case slog.LevelDebug:
+		level = color.MagentaString(level)
+	case slog.LevelInfo:
+		level = color.BlueString(level)
+	case slog.LevelWarn:
+		level = color.YellowString(level)
+	case slog.LevelError:
+		level = color.RedString(level)
+	}
+
+	fields := make(map[string]any, r.NumAttrs())
+
+	r.Attrs(func(a slog.Attr) bool {
+		fields[a.Key] = a.Value.Any()
+
+		return true
+	})
+
+	for _, a := range h.attrs {
+		fields[a.Key] = a.Value.Any()
+	}
+
+	var b []byte
+
+	if len(fields) > 0 {
+		var err error
+
+		b, err = json.MarshalIndent(fields, "", "  ")
+		if err != nil {
+			return err
+		}
+	}
+
+	timeStr := r.Time.Format("[15:04:05.000]")
+	msg := color.CyanString(r.Message)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintln(h.out, timeStr, level, msg, color.WhiteString(string(b)))
+
+	return nil
+}
+
+func (h *PrettyHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	newAttrs := make([]slog.Attr, len(h.attrs), len(h.attrs)+len(attrs))
+	copy(newAttrs, h.attrs)
+	newAttrs = append(newAttrs, attrs...)
+
+	return &PrettyHandler{
+		Handler: h.Handler,
+		attrs:   newAttrs,
+		out:     h.out,
+		mu:      h.mu,
+	}
+}
+
+func (h *PrettyHandler) WithGroup(name string) slog.Handler {
+	return &PrettyHandler{
+		Handler: h.Handler.WithGroup(name),
+		attrs:   h.attrs,
+		out:     h.out,
+		mu:      h.mu,
+	}
+}
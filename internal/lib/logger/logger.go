@@ -0,0 +1,136 @@
+// Package logger assembles the application's root *slog.Logger from
+// config: which level to log at, which format(s) to render records in,
+// and which sinks (stdout, a rotating file, syslog) to fan records out to.
+package logger
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"url-shortener/internal/config"
+	"url-shortener/internal/lib/logger/handler/slogmulti"
+	"url-shortener/internal/lib/logger/handler/slogpretty"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	envLocal = "local"
+	envDev   = "dev"
+	envProd  = "prod"
+)
+
+// Setup builds the root logger from cfg. It never returns nil: with no
+// outputs configured, an unknown env gets a JSON handler on stderr and a
+// known env logs to stdout; an output whose sink cannot be opened is
+// replaced by a JSON handler on stderr.
+func Setup(cfg *config.Config) *slog.Logger {
+	level := parseLevel(cfg.Logger.Level, cfg.Env)
+
+	outputs := cfg.Logger.Outputs
+	if len(outputs) == 0 {
+		switch cfg.Env {
+		case envLocal, envDev, envProd:
+		default:
+			return slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+		}
+
+		outputs = []config.LoggerOutput{{Type: config.LogOutputStdout}}
+	}
+
+	format := cfg.Logger.Format
+	if format == "" {
+		format = defaultFormat(cfg.Env)
+	}
+
+	handlers := make([]slog.Handler, 0, len(outputs))
+
+	for _, output := range outputs {
+		h, err := newHandler(output, format, level)
+		if err != nil {
+			handlers = append(handlers, slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+			continue
+		}
+
+		handlers = append(handlers, h)
+	}
+
+	if len(handlers) == 0 {
+		return slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+	}
+
+	if len(handlers) == 1 {
+		return slog.New(handlers[0])
+	}
+
+	return slog.New(slogmulti.Fanout(handlers...))
+}
+
+func newHandler(output config.LoggerOutput, format string, level slog.Leveler) (slog.Handler, error) {
+	var w io.Writer
+
+	switch output.Type {
+	case config.LogOutputStdout:
+		w = os.Stdout
+	case config.LogOutputFile:
+		w = &lumberjack.Logger{
+			Filename:   output.File.Path,
+			MaxSize:    output.File.MaxSize,
+			MaxBackups: output.File.MaxBackups,
+			MaxAge:     output.File.MaxAge,
+			Compress:   output.File.Compress,
+		}
+	case config.LogOutputSyslog:
+		syslogWriter, err := newSyslogWriter()
+		if err != nil {
+			return nil, err
+		}
+
+		w = syslogWriter
+	default:
+		w = os.Stdout
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	switch format {
+	case config.LogFormatPretty:
+		return (slogpretty.PrettyHandlerOptions{SlogOpts: opts}).NewPrettyHandler(w), nil
+	case config.LogFormatJSON:
+		return slog.NewJSONHandler(w, opts), nil
+	default:
+		return slog.NewTextHandler(w, opts), nil
+	}
+}
+
+func parseLevel(level string, env string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+	case "info":
+		return slog.LevelInfo
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+
+	if env == envLocal {
+		return slog.LevelDebug
+	}
+
+	return slog.LevelInfo
+}
+
+func defaultFormat(env string) string {
+	switch env {
+	case envLocal:
+		return config.LogFormatPretty
+	case envDev, envProd:
+		return config.LogFormatJSON
+	default:
+		return config.LogFormatJSON
+	}
+}
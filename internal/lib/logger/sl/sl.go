@@ -0,0 +1,12 @@
+package sl
+
+import "log/slog"
+
+// Error wraps an error as a slog.Attr so it renders consistently across
+// handlers instead of everyone reinventing slog.String("error", err.Error()).
+func Error(err error) slog.Attr {
+	return slog.Attr{
+		Key:   "error",
+		Value: slog.StringValue(err.Error()),
+	}
+}
@@ -0,0 +1,39 @@
+// Package random generates URL-safe aliases for shortened links.
+package random
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// DefaultAlphabet is used whenever a caller doesn't supply its own; it is
+// restricted to characters that are safe to drop straight into a URL path.
+const DefaultAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// New returns a random alias of the given length drawn from alphabet,
+// falling back to DefaultAlphabet when alphabet is empty. It uses
+// crypto/rand so aliases aren't guessable from a seed.
+func New(length int, alphabet string) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("random: length must be positive, got %d", length)
+	}
+
+	if alphabet == "" {
+		alphabet = DefaultAlphabet
+	}
+
+	alphabetSize := big.NewInt(int64(len(alphabet)))
+
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("random: failed to read random bytes: %w", err)
+		}
+
+		b[i] = alphabet[n.Int64()]
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,90 @@
+package random
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestNew_Length(t *testing.T) {
+	tests := []struct {
+		name   string
+		length int
+	}{
+		{"short", 4},
+		{"default", 6},
+		{"long", 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alias, err := New(tt.length, "")
+			if err != nil {
+				t.Fatalf("New(%d, \"\") returned error: %v", tt.length, err)
+			}
+
+			if len(alias) != tt.length {
+				t.Errorf("len(alias) = %d, want %d", len(alias), tt.length)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidLength(t *testing.T) {
+	if _, err := New(0, ""); err == nil {
+		t.Error("New(0, \"\") should have returned an error")
+	}
+}
+
+func TestNew_UsesGivenAlphabet(t *testing.T) {
+	const alphabet = "ab"
+
+	alias, err := New(32, alphabet)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	for _, c := range alias {
+		if c != 'a' && c != 'b' {
+			t.Fatalf("alias %q contains character %q not in alphabet %q", alias, c, alphabet)
+		}
+	}
+}
+
+// TestNew_Uniqueness is a property test: across many draws of length 8,
+// New should not produce the same alias twice.
+func TestNew_Uniqueness(t *testing.T) {
+	f := func(seed byte) bool {
+		const samples = 2000
+
+		seen := make(map[string]struct{}, samples)
+
+		for i := 0; i < samples; i++ {
+			alias, err := New(8, "")
+			if err != nil {
+				t.Fatalf("New returned error: %v", err)
+			}
+
+			if _, ok := seen[alias]; ok {
+				return false
+			}
+
+			seen[alias] = struct{}{}
+		}
+
+		return true
+	}
+
+	if err := quick.Check(f, &quick.Config{MaxCount: 3}); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := New(6, ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
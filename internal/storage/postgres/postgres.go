@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"url-shortener/internal/config"
+	"url-shortener/internal/storage"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func init() {
+	storage.Register(config.StorageTypePostgres, func(cfg *config.Config) (storage.Storage, error) {
+		return NewStorage(cfg.Storage.Postgres)
+	})
+}
+
+type Storage struct {
+	pool *pgxpool.Pool
+}
+
+func NewStorage(cfg config.PostgresStorage) (*Storage, error) {
+	const op = "storage.postgres.NewStorage"
+
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DB, cfg.SSLMode,
+	)
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := migrate(ctx, pool); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Storage{pool: pool}, nil
+}
+
+// migrate runs this driver's startup migrations against pool.
+func migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	_, err := pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS url(
+	    id SERIAL PRIMARY KEY,
+	    alias TEXT NOT NULL UNIQUE,
+	    url TEXT NOT NULL);
+	CREATE INDEX IF NOT EXISTS idx_alias ON url(alias);
+	`)
+
+	return err
+}
+
+func (s *Storage) SaveURL(urlToSave string, alias string) (int64, error) {
+	const op = "storage.postgres.SaveURL"
+
+	var id int64
+
+	err := s.pool.QueryRow(
+		context.Background(),
+		"INSERT INTO url(url, alias) VALUES($1, $2) RETURNING id",
+		urlToSave, alias,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrURLExists)
+		}
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) GetURL(alias string) (string, error) {
+	const op = "storage.postgres.GetURL"
+
+	var resURL string
+
+	err := s.pool.QueryRow(
+		context.Background(),
+		"SELECT url FROM url WHERE alias = $1",
+		alias,
+	).Scan(&resURL)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("%s: %w", op, storage.ErrURLNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return resURL, nil
+}
+
+func (s *Storage) DeleteURL(alias string) error {
+	const op = "storage.postgres.DeleteURL"
+
+	tag, err := s.pool.Exec(
+		context.Background(),
+		"DELETE FROM url WHERE alias = $1",
+		alias,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrURLNotFound)
+	}
+
+	return nil
+}
+
+// ListURLs returns up to limit rows ordered by id, starting after offset
+// rows, so callers can page through the full table by repeatedly bumping
+// offset by the number of rows they received.
+func (s *Storage) ListURLs(ctx context.Context, limit, offset int) ([]storage.URLRecord, error) {
+	const op = "storage.postgres.ListURLs"
+
+	rows, err := s.pool.Query(ctx, "SELECT id, alias, url FROM url ORDER BY id LIMIT $1 OFFSET $2", limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	records := make([]storage.URLRecord, 0, limit)
+
+	for rows.Next() {
+		var rec storage.URLRecord
+
+		if err := rows.Scan(&rec.ID, &rec.Alias, &rec.URL); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		records = append(records, rec)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"url-shortener/internal/config"
+)
+
+var (
+	ErrURLNotFound = errors.New("url not found")
+	ErrURLExists   = errors.New("url already exists")
+)
+
+// Storage is implemented by every storage driver so the HTTP handler layer
+// can depend on it instead of a concrete sqlite/postgres type.
+type Storage interface {
+	SaveURL(urlToSave string, alias string) (int64, error)
+	GetURL(alias string) (string, error)
+	DeleteURL(alias string) error
+}
+
+// URLRecord is a single row of the url table, returned by URLLister.
+type URLRecord struct {
+	ID    int64  `json:"id"`
+	Alias string `json:"alias"`
+	URL   string `json:"url"`
+}
+
+// URLLister is implemented by storage drivers that can page through the
+// full set of saved URLs, ordered by ID, for the admin listing endpoint.
+type URLLister interface {
+	ListURLs(ctx context.Context, limit, offset int) ([]URLRecord, error)
+}
+
+// Constructor builds a Storage from config. Drivers register their
+// constructor from an init() func, the same way database/sql drivers
+// register themselves, so this package never has to import them directly.
+type Constructor func(cfg *config.Config) (Storage, error)
+
+var drivers = make(map[string]Constructor)
+
+// Register makes a storage driver available under the given
+// cfg.Storage.Type name. It is meant to be called from a driver package's
+// init function.
+func Register(name string, constructor Constructor) {
+	drivers[name] = constructor
+}
+
+// New constructs the storage driver selected by cfg.Storage.Type. The
+// driver package (e.g. internal/storage/sqlite) must be imported, even if
+// only blank-imported, for its constructor to be registered.
+func New(cfg *config.Config) (Storage, error) {
+	const op = "storage.New"
+
+	constructor, ok := drivers[cfg.Storage.Type]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown storage type %q", op, cfg.Storage.Type)
+	}
+
+	storage, err := constructor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return storage, nil
+}